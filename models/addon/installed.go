@@ -0,0 +1,110 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package addon
+
+import (
+	"context"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/builder"
+)
+
+// Installed represents an add-on a game client reported as installed for a user
+type Installed struct {
+	ID          int64              `xorm:"pk autoincr"`
+	UserID      int64              `xorm:"UNIQUE(s)"`
+	RepoID      int64              `xorm:"UNIQUE(s) INDEX"`
+	Version     string             `xorm:"VARCHAR(255)"`
+	CreatedUnix timeutil.TimeStamp `xorm:"created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+}
+
+// TableName sets the table name for the installed add-on model
+func (*Installed) TableName() string {
+	return "addon_installed"
+}
+
+func init() {
+	db.RegisterModel(new(Installed))
+}
+
+// FindInstalledOptions represents the options to find installed add-ons
+type FindInstalledOptions struct {
+	db.ListOptions
+	UserID     int64
+	PublicOnly bool
+}
+
+func (opts FindInstalledOptions) ToConds() builder.Cond {
+	cond := builder.NewCond()
+	if opts.UserID != 0 {
+		cond = cond.And(builder.Eq{"user_id": opts.UserID})
+	}
+	if opts.PublicOnly {
+		cond = cond.And(builder.In("repo_id", builder.Select("id").From("repository").Where(builder.Eq{"is_private": false})))
+	}
+	return cond
+}
+
+func (opts FindInstalledOptions) ToOrders() string {
+	return "repo_id ASC"
+}
+
+// GetInstalledAddons returns all add-ons installed by a user, ordered by repository ID
+func GetInstalledAddons(ctx context.Context, userID int64) ([]*Installed, error) {
+	installed := make([]*Installed, 0, 10)
+	return installed, db.GetEngine(ctx).
+		Where("user_id = ?", userID).
+		OrderBy("repo_id ASC").
+		Find(&installed)
+}
+
+// ReplaceInstalledAddons replaces the installed add-on list of a user with the given one.
+// Entries whose version did not change are kept untouched so their timestamps stay meaningful.
+func ReplaceInstalledAddons(ctx context.Context, userID int64, addons []*Installed) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		existing, err := GetInstalledAddons(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		existingMap := make(map[int64]*Installed, len(existing))
+		for _, e := range existing {
+			existingMap[e.RepoID] = e
+		}
+
+		keep := make(map[int64]struct{}, len(addons))
+		for _, a := range addons {
+			keep[a.RepoID] = struct{}{}
+
+			e, ok := existingMap[a.RepoID]
+			if !ok {
+				if err := db.Insert(ctx, &Installed{UserID: userID, RepoID: a.RepoID, Version: a.Version}); err != nil {
+					return err
+				}
+				continue
+			}
+			if e.Version != a.Version {
+				e.Version = a.Version
+				if _, err := db.GetEngine(ctx).ID(e.ID).Cols("version").Update(e); err != nil {
+					return err
+				}
+			}
+		}
+
+		removed := make([]int64, 0, len(existing))
+		for _, e := range existing {
+			if _, ok := keep[e.RepoID]; !ok {
+				removed = append(removed, e.ID)
+			}
+		}
+		if len(removed) == 0 {
+			return nil
+		}
+		_, err = db.GetEngine(ctx).Where(builder.In("id", removed)).Delete(new(Installed))
+		return err
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package addon
+
+import (
+	"context"
+
+	"code.gitea.io/gitea/models/db"
+)
+
+// Related represents an add-on that is frequently installed together with another add-on
+type Related struct {
+	ID            int64 `xorm:"pk autoincr"`
+	RepoID        int64 `xorm:"UNIQUE(s)"`
+	RelatedRepoID int64 `xorm:"UNIQUE(s) INDEX"`
+	// Score is the number of users who have installed both add-ons
+	Score int64 `xorm:"NOT NULL DEFAULT 0"`
+}
+
+// TableName sets the table name for the related add-on model
+func (*Related) TableName() string {
+	return "addon_related"
+}
+
+func init() {
+	db.RegisterModel(new(Related))
+}
+
+// GetRelatedAddons returns the add-ons most frequently installed together with the given one
+func GetRelatedAddons(ctx context.Context, repoID int64, limit int) ([]*Related, error) {
+	related := make([]*Related, 0, 10)
+	return related, db.GetEngine(ctx).
+		Where("repo_id = ?", repoID).
+		OrderBy("score DESC, related_repo_id ASC").
+		Limit(limit).
+		Find(&related)
+}
+
+// RebuildRelatedAddons recalculates the related add-ons from the installed add-on lists of all users,
+// keeping at most maxPerAddon entries for every add-on
+func RebuildRelatedAddons(ctx context.Context, maxPerAddon int) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("1=1").Delete(new(Related)); err != nil {
+			return err
+		}
+
+		var repoIDs []int64
+		if err := db.GetEngine(ctx).Table("addon_installed").Select("repo_id").Distinct("repo_id").Find(&repoIDs); err != nil {
+			return err
+		}
+
+		// rank one add-on at a time so only maxPerAddon suggestions are ever loaded into memory
+		for _, repoID := range repoIDs {
+			var related []*Related
+			if err := db.GetEngine(ctx).Table("addon_installed").Alias("a").
+				Join("INNER", []string{"addon_installed", "b"}, "a.user_id = b.user_id AND a.repo_id <> b.repo_id").
+				Select("a.repo_id AS repo_id, b.repo_id AS related_repo_id, COUNT(*) AS score").
+				Where("a.repo_id = ?", repoID).
+				GroupBy("a.repo_id, b.repo_id").
+				OrderBy("COUNT(*) DESC, b.repo_id ASC").
+				Limit(maxPerAddon).
+				Find(&related); err != nil {
+				return err
+			}
+			if len(related) == 0 {
+				continue
+			}
+			if err := db.Insert(ctx, related); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
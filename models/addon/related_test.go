@@ -0,0 +1,49 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package addon_test
+
+import (
+	"testing"
+
+	addon_model "code.gitea.io/gitea/models/addon"
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/models/unittest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebuildRelatedAddons(t *testing.T) {
+	assert.NoError(t, unittest.PrepareTestDatabase())
+
+	assert.NoError(t, addon_model.ReplaceInstalledAddons(db.DefaultContext, 1, []*addon_model.Installed{{RepoID: 1}, {RepoID: 4}}))
+	assert.NoError(t, addon_model.ReplaceInstalledAddons(db.DefaultContext, 2, []*addon_model.Installed{{RepoID: 1}, {RepoID: 4}, {RepoID: 10}}))
+	assert.NoError(t, addon_model.ReplaceInstalledAddons(db.DefaultContext, 5, []*addon_model.Installed{{RepoID: 1}, {RepoID: 10}, {RepoID: 16}}))
+
+	assert.NoError(t, addon_model.RebuildRelatedAddons(db.DefaultContext, 2))
+
+	related, err := addon_model.GetRelatedAddons(db.DefaultContext, 1, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, related, 2) {
+		assert.EqualValues(t, 4, related[0].RelatedRepoID)
+		assert.EqualValues(t, 2, related[0].Score)
+		assert.EqualValues(t, 10, related[1].RelatedRepoID)
+		assert.EqualValues(t, 2, related[1].Score)
+	}
+
+	related, err = addon_model.GetRelatedAddons(db.DefaultContext, 16, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, related, 2) {
+		assert.EqualValues(t, 1, related[0].RelatedRepoID)
+		assert.EqualValues(t, 1, related[0].Score)
+		assert.EqualValues(t, 10, related[1].RelatedRepoID)
+	}
+
+	// rebuilding replaces the previous suggestions
+	assert.NoError(t, addon_model.ReplaceInstalledAddons(db.DefaultContext, 5, nil))
+	assert.NoError(t, addon_model.RebuildRelatedAddons(db.DefaultContext, 2))
+
+	related, err = addon_model.GetRelatedAddons(db.DefaultContext, 16, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, related)
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package addon
+
+import (
+	"fmt"
+	"strings"
+
+	"code.gitea.io/gitea/modules/util"
+)
+
+// Types are the repository topics which mark a repository as an add-on of the given type
+var Types = []string{"worldmap", "world", "levelset", "languagepack", "resourcepack", "addon"}
+
+// IsTypeTopic returns whether the topic marks the type of an add-on
+func IsTypeTopic(topic string) bool {
+	for _, t := range Types {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrMultipleTypeTopics represents a "MultipleTypeTopics" kind of error.
+type ErrMultipleTypeTopics struct {
+	Topics []string
+}
+
+func (err ErrMultipleTypeTopics) Error() string {
+	return fmt.Sprintf("a repository can only have one add-on type topic, got: %s", strings.Join(err.Topics, ", "))
+}
+
+func (err ErrMultipleTypeTopics) Unwrap() error {
+	return util.ErrInvalidArgument
+}
+
+// ValidateTypeTopics makes sure at most one of the topics marks the type of an add-on,
+// otherwise the effective add-on type would depend on the topic order
+func ValidateTypeTopics(topics []string) error {
+	var typeTopics []string
+	for _, topic := range topics {
+		if IsTypeTopic(topic) {
+			typeTopics = append(typeTopics, topic)
+		}
+	}
+	if len(typeTopics) > 1 {
+		return ErrMultipleTypeTopics{Topics: typeTopics}
+	}
+	return nil
+}
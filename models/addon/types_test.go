@@ -0,0 +1,21 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package addon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTypeTopics(t *testing.T) {
+	assert.NoError(t, ValidateTypeTopics(nil))
+	assert.NoError(t, ValidateTypeTopics([]string{"supertux", "levelset"}))
+
+	var errMultiple ErrMultipleTypeTopics
+	err := ValidateTypeTopics([]string{"levelset", "supertux", "worldmap"})
+	if assert.ErrorAs(t, err, &errMultiple) {
+		assert.Equal(t, []string{"levelset", "worldmap"}, errMultiple.Topics)
+	}
+}
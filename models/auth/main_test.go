@@ -11,6 +11,7 @@ import (
 	_ "code.gitea.io/gitea/models"
 	_ "code.gitea.io/gitea/models/actions"
 	_ "code.gitea.io/gitea/models/activities"
+	_ "code.gitea.io/gitea/models/addon"
 	_ "code.gitea.io/gitea/models/auth"
 	_ "code.gitea.io/gitea/models/perm/access"
 )
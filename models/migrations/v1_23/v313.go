@@ -0,0 +1,23 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_23 //nolint
+
+import (
+	"xorm.io/xorm"
+)
+
+type AddonRelated struct {
+	ID            int64 `xorm:"pk autoincr"`
+	RepoID        int64 `xorm:"UNIQUE(s)"`
+	RelatedRepoID int64 `xorm:"UNIQUE(s) INDEX"`
+	Score         int64 `xorm:"NOT NULL DEFAULT 0"`
+}
+
+func (*AddonRelated) TableName() string {
+	return "addon_related"
+}
+
+func AddAddonRelatedTable(x *xorm.Engine) error {
+	return x.Sync(new(AddonRelated))
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package setting
+
+import "code.gitea.io/gitea/modules/setting/config"
+
+// Addon settings
+var (
+	Addon = struct {
+		Enabled bool
+	}{
+		Enabled: false,
+	}
+)
+
+func loadAddonFrom(rootCfg ConfigProvider) {
+	mustMapSetting(rootCfg, "addon", &Addon)
+}
+
+// DefaultMaxRelatedAddons is the number of related add-ons kept per add-on if MAX_RELATED_ADDONS is not set
+// to a positive number
+const DefaultMaxRelatedAddons = 10
+
+// AddonStruct contains the add-on settings which can be changed at runtime
+type AddonStruct struct {
+	MaxInstalledAddons *config.Value[int]
+	MaxRelatedAddons   *config.Value[int]
+}
+
+func newAddonConfig() *AddonStruct {
+	return &AddonStruct{
+		MaxInstalledAddons: config.ValueJSON[int]("addon.max_installed_addons").WithFileConfig(config.CfgSecKey{Sec: "addon", Key: "MAX_INSTALLED_ADDONS"}).WithDefault(500),
+		MaxRelatedAddons:   config.ValueJSON[int]("addon.max_related_addons").WithFileConfig(config.CfgSecKey{Sec: "addon", Key: "MAX_RELATED_ADDONS"}).WithDefault(DefaultMaxRelatedAddons),
+	}
+}
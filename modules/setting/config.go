@@ -54,6 +54,7 @@ type RepositoryStruct struct {
 type ConfigStruct struct {
 	Picture    *PictureStruct
 	Repository *RepositoryStruct
+	Addon      *AddonStruct
 }
 
 var (
@@ -71,6 +72,7 @@ func initDefaultConfig() {
 		Repository: &RepositoryStruct{
 			OpenWithEditorApps: config.ValueJSON[OpenWithEditorAppsType]("repository.open-with.editor-apps"),
 		},
+		Addon: newAddonConfig(),
 	}
 }
 
@@ -0,0 +1,33 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package structs
+
+import (
+	"time"
+)
+
+// InstalledAddon represents an add-on a game client has installed
+// swagger:model
+type InstalledAddon struct {
+	// ID of the add-on repository
+	ID      int64  `json:"id"`
+	Version string `json:"version"`
+	// swagger:strfmt date-time
+	Created time.Time `json:"created_at"`
+	// swagger:strfmt date-time
+	Updated time.Time `json:"updated_at"`
+}
+
+// InstalledAddonOption represents a single add-on of an installed add-on list
+type InstalledAddonOption struct {
+	// ID of the add-on repository
+	// required: true
+	ID      int64  `json:"id" binding:"Required"`
+	Version string `json:"version" binding:"MaxSize(255)"`
+}
+
+// ReplaceInstalledAddonsOption options for replacing the installed add-on list of the authenticated user
+type ReplaceInstalledAddonsOption struct {
+	Addons []InstalledAddonOption `json:"addons"`
+}
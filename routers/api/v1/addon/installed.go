@@ -0,0 +1,103 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package addon
+
+import (
+	"errors"
+	"net/http"
+
+	addon_model "code.gitea.io/gitea/models/addon"
+	"code.gitea.io/gitea/models/db"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/routers/api/v1/utils"
+	addon_service "code.gitea.io/gitea/services/addon"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/convert"
+)
+
+// ListInstalledAddons list the add-ons installed by the authenticated user
+func ListInstalledAddons(ctx *context.APIContext) {
+	// swagger:operation GET /user/addons/installed user userListInstalledAddons
+	// ---
+	// summary: List the add-ons installed by the authenticated user
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/InstalledAddonList"
+
+	listOptions := utils.GetListOptions(ctx)
+	installed, total, err := db.FindAndCount[addon_model.Installed](ctx, addon_model.FindInstalledOptions{
+		ListOptions: listOptions,
+		UserID:      ctx.Doer.ID,
+		PublicOnly:  ctx.PublicOnly,
+	})
+	if err != nil {
+		ctx.Error(http.StatusInternalServerError, "FindInstalledAddons", err)
+		return
+	}
+
+	ctx.SetLinkHeader(int(total), listOptions.PageSize)
+	ctx.SetTotalCountHeader(total)
+	ctx.JSON(http.StatusOK, convert.ToInstalledAddonList(installed))
+}
+
+// ReplaceInstalledAddons replace the add-ons installed by the authenticated user
+func ReplaceInstalledAddons(ctx *context.APIContext) {
+	// swagger:operation PUT /user/addons/installed user userReplaceInstalledAddons
+	// ---
+	// summary: Replace the add-ons installed by the authenticated user
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/ReplaceInstalledAddonsOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/InstalledAddonList"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.ReplaceInstalledAddonsOption)
+
+	addons := make([]*addon_model.Installed, 0, len(form.Addons))
+	for _, a := range form.Addons {
+		addons = append(addons, &addon_model.Installed{
+			RepoID:  a.ID,
+			Version: a.Version,
+		})
+	}
+
+	if err := addon_service.ReplaceInstalledAddons(ctx, ctx.Doer, addons, ctx.PublicOnly); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.Error(http.StatusUnprocessableEntity, "ReplaceInstalledAddons", err)
+		} else {
+			ctx.Error(http.StatusInternalServerError, "ReplaceInstalledAddons", err)
+		}
+		return
+	}
+
+	// respond with the whole stored list, the client just sent all of it
+	installed, err := addon_model.GetInstalledAddons(ctx, ctx.Doer.ID)
+	if err != nil {
+		ctx.Error(http.StatusInternalServerError, "GetInstalledAddons", err)
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToInstalledAddonList(installed))
+}
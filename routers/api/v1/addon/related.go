@@ -0,0 +1,112 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package addon
+
+import (
+	"net/http"
+
+	access_model "code.gitea.io/gitea/models/perm/access"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/models/unit"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/routers/api/v1/utils"
+	addon_service "code.gitea.io/gitea/services/addon"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/convert"
+)
+
+// ListRelatedAddons lists the add-ons players frequently install together with an add-on
+func ListRelatedAddons(ctx *context.APIContext) {
+	// swagger:operation GET /repos/addons/{id}/related repository repoListRelatedAddons
+	// ---
+	// summary: List the add-ons players frequently install together with an add-on
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: id of the add-on repository
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/RepositoryList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	repo, err := repo_model.GetRepositoryByID(ctx, ctx.PathParamInt64("id"))
+	if err != nil {
+		if repo_model.IsErrRepoNotExist(err) {
+			ctx.NotFound()
+		} else {
+			ctx.Error(http.StatusInternalServerError, "GetRepositoryByID", err)
+		}
+		return
+	}
+	if ctx.PublicOnly && repo.IsPrivate {
+		ctx.NotFound()
+		return
+	}
+
+	permission, err := access_model.GetUserRepoPermission(ctx, repo, ctx.Doer)
+	if err != nil {
+		ctx.Error(http.StatusInternalServerError, "GetUserRepoPermission", err)
+		return
+	} else if !permission.CanRead(unit.TypeCode) {
+		ctx.NotFound()
+		return
+	}
+
+	related, err := addon_service.GetRelatedAddons(ctx, repo.ID)
+	if err != nil {
+		ctx.Error(http.StatusInternalServerError, "GetRelatedAddons", err)
+		return
+	}
+
+	repoIDs := make([]int64, 0, len(related))
+	for _, r := range related {
+		repoIDs = append(repoIDs, r.RelatedRepoID)
+	}
+	repos, err := repo_model.GetRepositoriesMapByIDs(ctx, repoIDs)
+	if err != nil {
+		ctx.Error(http.StatusInternalServerError, "GetRepositoriesMapByIDs", err)
+		return
+	}
+
+	results := make([]*api.Repository, 0, len(related))
+	for _, r := range related {
+		relatedRepo, ok := repos[r.RelatedRepoID]
+		if !ok || (ctx.PublicOnly && relatedRepo.IsPrivate) {
+			continue
+		}
+		if err := relatedRepo.LoadOwner(ctx); err != nil {
+			ctx.Error(http.StatusInternalServerError, "LoadOwner", err)
+			return
+		}
+		permission, err := access_model.GetUserRepoPermission(ctx, relatedRepo, ctx.Doer)
+		if err != nil {
+			ctx.Error(http.StatusInternalServerError, "GetUserRepoPermission", err)
+			return
+		} else if !permission.CanRead(unit.TypeCode) {
+			continue
+		}
+		results = append(results, convert.ToRepo(ctx, relatedRepo, permission))
+	}
+
+	// the suggestions are bounded by MaxRelatedAddons and filtered by permission, so paginate them in memory
+	listOptions := utils.GetListOptions(ctx)
+	ctx.SetLinkHeader(len(results), listOptions.PageSize)
+	ctx.SetTotalCountHeader(int64(len(results)))
+	ctx.JSON(http.StatusOK, util.PaginateSlice(results, listOptions.Page, listOptions.PageSize))
+}
@@ -0,0 +1,28 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1
+
+import (
+	auth_model "code.gitea.io/gitea/models/auth"
+	"code.gitea.io/gitea/modules/setting"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/routers/api/v1/addon"
+)
+
+// addonRoutes registers the routes of the add-on subsystem.
+// They are kept apart from the upstream routes to ease rebasing onto new Gitea releases.
+func addonRoutes(m *web.Router) {
+	if !setting.Addon.Enabled {
+		return
+	}
+
+	m.Combo("/user/addons/installed", tokenRequiresScopes(auth_model.AccessTokenScopeCategoryAddon), reqToken()).
+		Get(addon.ListInstalledAddons).
+		Put(bind(api.ReplaceInstalledAddonsOption{}), addon.ReplaceInstalledAddons)
+
+	m.Group("/repos/addons/{id}", func() {
+		m.Get("/related", addon.ListRelatedAddons)
+	}, tokenRequiresScopes(auth_model.AccessTokenScopeCategoryAddon))
+}
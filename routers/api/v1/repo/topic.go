@@ -121,6 +121,10 @@ func UpdateTopics(ctx *context.APIContext) {
 		return
 	}
 
+	if !checkAddonTopics(ctx, 0, validTopics...) {
+		return
+	}
+
 	err := repo_model.SaveTopics(ctx, ctx.Repo.Repository.ID, validTopics...)
 	if err != nil {
 		log.Error("SaveTopics failed: %v", err)
@@ -188,6 +192,10 @@ func AddTopic(ctx *context.APIContext) {
 		return
 	}
 
+	if !checkAddonTopics(ctx, ctx.Repo.Repository.ID, topicName) {
+		return
+	}
+
 	_, err = repo_model.AddTopic(ctx, ctx.Repo.Repository.ID, topicName)
 	if err != nil {
 		log.Error("AddTopic failed: %v", err)
@@ -0,0 +1,30 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"net/http"
+
+	addon_model "code.gitea.io/gitea/models/addon"
+	addon_service "code.gitea.io/gitea/services/addon"
+	"code.gitea.io/gitea/services/context"
+)
+
+// checkAddonTopics responds with an error if the topics would give the repository more than one add-on type.
+// It returns whether the request may go on.
+func checkAddonTopics(ctx *context.APIContext, repoID int64, topics ...string) bool {
+	invalid, err := addon_service.CheckTopics(ctx, repoID, topics)
+	if err != nil {
+		ctx.InternalServerError(err)
+		return false
+	}
+	if len(invalid) > 0 {
+		ctx.JSON(http.StatusUnprocessableEntity, map[string]any{
+			"invalidTopics": invalid,
+			"message":       addon_model.ErrMultipleTypeTopics{Topics: invalid}.Error(),
+		})
+		return false
+	}
+	return true
+}
@@ -0,0 +1,13 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package swagger
+
+import api "code.gitea.io/gitea/modules/structs"
+
+// InstalledAddonList
+// swagger:response InstalledAddonList
+type swaggerResponseInstalledAddonList struct {
+	// in:body
+	Body []api.InstalledAddon `json:"body"`
+}
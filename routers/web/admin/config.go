@@ -145,6 +145,7 @@ func Config(ctx *context.Context) {
 	ctx.Data["Service"] = setting.Service
 	ctx.Data["DbCfg"] = setting.Database
 	ctx.Data["Webhook"] = setting.Webhook
+	ctx.Data["Addon"] = setting.Addon
 
 	ctx.Data["MailerEnabled"] = false
 	if setting.MailService != nil {
@@ -192,6 +193,7 @@ func ConfigSettings(ctx *context.Context) {
 	ctx.Data["PageIsAdminConfig"] = true
 	ctx.Data["PageIsAdminConfigSettings"] = true
 	ctx.Data["DefaultOpenWithEditorAppsString"] = setting.DefaultOpenWithEditorApps().ToTextareaString()
+	ctx.Data["Addon"] = setting.Addon
 	ctx.HTML(http.StatusOK, tplConfigSettings)
 }
 
@@ -236,6 +238,9 @@ func ChangeConfig(ctx *context.Context) {
 		cfg.Repository.OpenWithEditorApps.DynKey(): marshalOpenWithApps,
 	}
 	marshaller, hasMarshaller := marshallers[key]
+	if !hasMarshaller {
+		marshaller, hasMarshaller = addonConfigMarshallers(cfg)[key]
+	}
 	if !hasMarshaller {
 		ctx.JSONError(ctx.Tr("admin.config.set_setting_failed", key))
 		return
@@ -0,0 +1,29 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package admin
+
+import (
+	"strconv"
+	"strings"
+
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/util"
+)
+
+// addonConfigMarshallers returns the marshallers of the dynamic add-on settings which can be changed by ChangeConfig
+func addonConfigMarshallers(cfg *setting.ConfigStruct) map[string]func(string) (string, error) {
+	marshalPositiveInt := func(v string) (string, error) {
+		i, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return "", err
+		} else if i <= 0 {
+			return "", util.NewInvalidArgumentErrorf("value must be positive")
+		}
+		return strconv.Itoa(i), nil
+	}
+	return map[string]func(string) (string, error){
+		cfg.Addon.MaxInstalledAddons.DynKey(): marshalPositiveInt,
+		cfg.Addon.MaxRelatedAddons.DynKey():   marshalPositiveInt,
+	}
+}
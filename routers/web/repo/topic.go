@@ -45,6 +45,10 @@ func TopicsPost(ctx *context.Context) {
 		return
 	}
 
+	if !checkAddonTopics(ctx, validTopics) {
+		return
+	}
+
 	err := repo_model.SaveTopics(ctx, ctx.Repo.Repository.ID, validTopics...)
 	if err != nil {
 		log.Error("SaveTopics failed: %v", err)
@@ -0,0 +1,34 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"net/http"
+	"strings"
+
+	"code.gitea.io/gitea/modules/log"
+	addon_service "code.gitea.io/gitea/services/addon"
+	"code.gitea.io/gitea/services/context"
+)
+
+// checkAddonTopics responds with an error if the topics would give the repository more than one add-on type.
+// It returns whether the request may go on.
+func checkAddonTopics(ctx *context.Context, topics []string) bool {
+	invalid, err := addon_service.CheckTopics(ctx, 0, topics)
+	if err != nil {
+		log.Error("CheckTopics failed: %v", err)
+		ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"message": "Save topics failed.",
+		})
+		return false
+	}
+	if len(invalid) > 0 {
+		ctx.JSON(http.StatusUnprocessableEntity, map[string]any{
+			"invalidTopics": invalid,
+			"message":       ctx.Tr("repo.topic.addon_type_prompt", strings.Join(invalid, ", ")),
+		})
+		return false
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package addon
+
+import (
+	"context"
+
+	addon_model "code.gitea.io/gitea/models/addon"
+	access_model "code.gitea.io/gitea/models/perm/access"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/models/unit"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/util"
+)
+
+// ReplaceInstalledAddons validates the installed add-on list reported by a game client and stores it for the user.
+// If publicOnly is set, as for public-only tokens, private add-ons are rejected.
+func ReplaceInstalledAddons(ctx context.Context, doer *user_model.User, addons []*addon_model.Installed, publicOnly bool) error {
+	if maxInstalled := setting.Config().Addon.MaxInstalledAddons.Value(ctx); len(addons) > maxInstalled {
+		return util.NewInvalidArgumentErrorf("too many installed add-ons: %d, the limit is %d", len(addons), maxInstalled)
+	}
+
+	repoIDs := make([]int64, 0, len(addons))
+	seen := make(map[int64]struct{}, len(addons))
+	for _, a := range addons {
+		if _, ok := seen[a.RepoID]; ok {
+			return util.NewInvalidArgumentErrorf("add-on %d is listed more than once", a.RepoID)
+		}
+		seen[a.RepoID] = struct{}{}
+		repoIDs = append(repoIDs, a.RepoID)
+	}
+
+	repos, err := repo_model.GetRepositoriesMapByIDs(ctx, repoIDs)
+	if err != nil {
+		return err
+	}
+	for _, id := range repoIDs {
+		repo, ok := repos[id]
+		if !ok || (publicOnly && repo.IsPrivate) {
+			return util.NewInvalidArgumentErrorf("add-on %d does not exist", id)
+		}
+		perm, err := access_model.GetUserRepoPermission(ctx, repo, doer)
+		if err != nil {
+			return err
+		}
+		// don't reveal whether an add-on exists if the user can't read it
+		if !perm.CanRead(unit.TypeCode) {
+			return util.NewInvalidArgumentErrorf("add-on %d does not exist", id)
+		}
+	}
+
+	return addon_model.ReplaceInstalledAddons(ctx, doer.ID, addons)
+}
@@ -0,0 +1,31 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package addon
+
+import (
+	"context"
+
+	addon_model "code.gitea.io/gitea/models/addon"
+	"code.gitea.io/gitea/modules/setting"
+)
+
+// maxRelatedAddons returns the number of related add-ons kept per add-on.
+// The admin panel only accepts positive numbers, but app.ini is not validated.
+func maxRelatedAddons(ctx context.Context) int {
+	if limit := setting.Config().Addon.MaxRelatedAddons.Value(ctx); limit > 0 {
+		return limit
+	}
+	return setting.DefaultMaxRelatedAddons
+}
+
+// GetRelatedAddons returns the add-ons most frequently installed together with the given one
+func GetRelatedAddons(ctx context.Context, repoID int64) ([]*addon_model.Related, error) {
+	return addon_model.GetRelatedAddons(ctx, repoID, maxRelatedAddons(ctx))
+}
+
+// UpdateRelatedAddons recalculates the "players also installed" suggestions of all add-ons
+// from the installed add-on lists synced by game clients
+func UpdateRelatedAddons(ctx context.Context) error {
+	return addon_model.RebuildRelatedAddons(ctx, maxRelatedAddons(ctx))
+}
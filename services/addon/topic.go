@@ -0,0 +1,69 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package addon
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	addon_model "code.gitea.io/gitea/models/addon"
+	"code.gitea.io/gitea/models/db"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/setting"
+)
+
+// CheckTopics checks the topics about to be stored on a repository, returning the add-on type topics
+// conflicting with each other. If repoID is not zero, the topics are added to the current ones of the
+// repository instead of replacing them.
+func CheckTopics(ctx context.Context, repoID int64, topics []string) (invalid []string, err error) {
+	if !setting.Addon.Enabled {
+		return nil, nil
+	}
+
+	if repoID != 0 {
+		current, err := db.Find[repo_model.Topic](ctx, &repo_model.FindTopicOptions{RepoID: repoID})
+		if err != nil {
+			return nil, err
+		}
+		all := make([]string, 0, len(current)+len(topics))
+		for _, topic := range current {
+			all = append(all, topic.Name)
+		}
+		for _, topic := range topics {
+			// adding a topic the repository already has is a no-op
+			if !slices.Contains(all, topic) {
+				all = append(all, topic)
+			}
+		}
+		topics = all
+	}
+
+	var errMultiple addon_model.ErrMultipleTypeTopics
+	if err := addon_model.ValidateTypeTopics(topics); errors.As(err, &errMultiple) {
+		return errMultiple.Topics, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// RemoveConflictingTypeTopics drops the add-on type topics conflicting with each other from the topics of a
+// migrated repository, as there is nobody to ask which add-on type is meant
+func RemoveConflictingTypeTopics(ctx context.Context, repoID int64, topics []string) ([]string, error) {
+	invalid, err := CheckTopics(ctx, 0, topics)
+	if err != nil || len(invalid) == 0 {
+		return topics, err
+	}
+
+	log.Warn("Repository %d: dropping conflicting add-on type topics %v", repoID, invalid)
+	kept := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		if !slices.Contains(invalid, topic) {
+			kept = append(kept, topic)
+		}
+	}
+	return kept, nil
+}
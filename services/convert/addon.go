@@ -0,0 +1,28 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package convert
+
+import (
+	addon_model "code.gitea.io/gitea/models/addon"
+	api "code.gitea.io/gitea/modules/structs"
+)
+
+// ToInstalledAddon converts an installed add-on to API format
+func ToInstalledAddon(installed *addon_model.Installed) *api.InstalledAddon {
+	return &api.InstalledAddon{
+		ID:      installed.RepoID,
+		Version: installed.Version,
+		Created: installed.CreatedUnix.AsTime(),
+		Updated: installed.UpdatedUnix.AsTime(),
+	}
+}
+
+// ToInstalledAddonList converts a list of installed add-ons to API format
+func ToInstalledAddonList(installed []*addon_model.Installed) []*api.InstalledAddon {
+	result := make([]*api.InstalledAddon, len(installed))
+	for i := range installed {
+		result[i] = ToInstalledAddon(installed[i])
+	}
+	return result
+}
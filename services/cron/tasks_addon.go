@@ -0,0 +1,29 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package cron
+
+import (
+	"context"
+
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/setting"
+	addon_service "code.gitea.io/gitea/services/addon"
+)
+
+func initAddonTasks() {
+	if !setting.Addon.Enabled {
+		return
+	}
+	registerUpdateRelatedAddons()
+}
+
+func registerUpdateRelatedAddons() {
+	RegisterTaskFatal("update_related_addons", &BaseConfig{
+		Enabled:    true,
+		RunAtStart: false,
+		Schedule:   "@midnight",
+	}, func(ctx context.Context, _ *user_model.User, _ Config) error {
+		return addon_service.UpdateRelatedAddons(ctx)
+	})
+}
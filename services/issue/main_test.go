@@ -10,6 +10,7 @@ import (
 
 	_ "code.gitea.io/gitea/models"
 	_ "code.gitea.io/gitea/models/actions"
+	_ "code.gitea.io/gitea/models/addon"
 )
 
 func TestMain(m *testing.M) {
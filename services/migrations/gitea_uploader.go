@@ -31,6 +31,7 @@ import (
 	"code.gitea.io/gitea/modules/timeutil"
 	"code.gitea.io/gitea/modules/uri"
 	"code.gitea.io/gitea/modules/util"
+	addon_service "code.gitea.io/gitea/services/addon"
 	"code.gitea.io/gitea/services/pull"
 	repo_service "code.gitea.io/gitea/services/repository"
 
@@ -173,6 +174,11 @@ func (g *GiteaLocalUploader) CreateTopics(topics ...string) error {
 		c++
 	}
 	topics = topics[:c]
+
+	topics, err := addon_service.RemoveConflictingTypeTopics(g.ctx, g.repo.ID, topics)
+	if err != nil {
+		return err
+	}
 	return repo_model.SaveTopics(g.ctx, g.repo.ID, topics...)
 }
 
@@ -24,6 +24,7 @@ import (
 	"code.gitea.io/gitea/modules/log"
 	base "code.gitea.io/gitea/modules/migration"
 	"code.gitea.io/gitea/modules/optional"
+	"code.gitea.io/gitea/modules/setting"
 	"code.gitea.io/gitea/modules/structs"
 	"code.gitea.io/gitea/modules/test"
 	repo_service "code.gitea.io/gitea/services/repository"
@@ -519,3 +520,20 @@ func TestGiteaUploadUpdateGitForPullRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestGiteaUploadTopicsWithAddonTypes(t *testing.T) {
+	unittest.PrepareTestEnv(t)
+	defer test.MockVariableValue(&setting.Addon.Enabled, true)()
+
+	repo := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+	uploader := &GiteaLocalUploader{ctx: db.DefaultContext, repo: repo}
+
+	// the add-on type would depend on the topic order, so none of the conflicting ones is kept
+	assert.NoError(t, uploader.CreateTopics("world", "supertux", "levelset"))
+
+	topics, err := db.Find[repo_model.Topic](db.DefaultContext, &repo_model.FindTopicOptions{RepoID: repo.ID})
+	assert.NoError(t, err)
+	if assert.Len(t, topics, 1) {
+		assert.Equal(t, "supertux", topics[0].Name)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"testing"
 
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/setting"
 	"code.gitea.io/gitea/modules/test"
 	"code.gitea.io/gitea/tests"
 
@@ -21,3 +23,27 @@ func TestAdminConfig(t *testing.T) {
 	resp := session.MakeRequest(t, req, http.StatusOK)
 	assert.True(t, test.IsNormalPageCompleted(resp.Body.String()))
 }
+
+func TestAdminConfigAddon(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	session := loginUser(t, "user1")
+	maxInstalled := setting.Config().Addon.MaxInstalledAddons
+
+	req := NewRequestWithValues(t, "POST", "/-/admin/config?key="+maxInstalled.DynKey(), map[string]string{
+		"_csrf": GetUserCSRFToken(t, session),
+		"value": "0",
+	})
+	session.MakeRequest(t, req, http.StatusBadRequest)
+
+	req = NewRequestWithValues(t, "POST", "/-/admin/config?key="+maxInstalled.DynKey(), map[string]string{
+		"_csrf": GetUserCSRFToken(t, session),
+		"value": "20",
+	})
+	session.MakeRequest(t, req, http.StatusOK)
+	assert.Equal(t, 20, maxInstalled.Value(db.DefaultContext))
+
+	req = NewRequest(t, "GET", "/-/admin/config/settings")
+	resp := session.MakeRequest(t, req, http.StatusOK)
+	assert.True(t, test.IsNormalPageCompleted(resp.Body.String()))
+}
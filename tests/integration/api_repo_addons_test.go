@@ -0,0 +1,110 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	addon_model "code.gitea.io/gitea/models/addon"
+	auth_model "code.gitea.io/gitea/models/auth"
+	"code.gitea.io/gitea/models/db"
+	system_model "code.gitea.io/gitea/models/system"
+	"code.gitea.io/gitea/modules/setting"
+	api "code.gitea.io/gitea/modules/structs"
+	addon_service "code.gitea.io/gitea/services/addon"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIRelatedAddons(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	// repo 2 is private and only readable by its owner user2
+	assert.NoError(t, addon_model.ReplaceInstalledAddons(db.DefaultContext, 2, []*addon_model.Installed{{RepoID: 1}, {RepoID: 2}, {RepoID: 4}}))
+	assert.NoError(t, addon_model.ReplaceInstalledAddons(db.DefaultContext, 5, []*addon_model.Installed{{RepoID: 1}, {RepoID: 4}}))
+	assert.NoError(t, addon_service.UpdateRelatedAddons(db.DefaultContext))
+
+	listRelated := func(t *testing.T, token string, repoID int64, expectedStatus int) []*api.Repository {
+		req := NewRequestf(t, "GET", "/api/v1/repos/addons/%d/related", repoID).
+			AddTokenAuth(token)
+		resp := MakeRequest(t, req, expectedStatus)
+		if expectedStatus != http.StatusOK {
+			return nil
+		}
+
+		var repos []*api.Repository
+		DecodeJSON(t, resp, &repos)
+		return repos
+	}
+
+	t.Run("Owner", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		token := getUserToken(t, "user2", auth_model.AccessTokenScopeReadAddon)
+
+		repos := listRelated(t, token, 1, http.StatusOK)
+		if assert.Len(t, repos, 2) {
+			assert.EqualValues(t, 4, repos[0].ID)
+			assert.EqualValues(t, 2, repos[1].ID)
+		}
+
+		repos = listRelated(t, token, 2, http.StatusOK)
+		if assert.Len(t, repos, 2) {
+			assert.EqualValues(t, 1, repos[0].ID)
+			assert.EqualValues(t, 4, repos[1].ID)
+		}
+	})
+
+	t.Run("OtherUser", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		token := getUserToken(t, "user4", auth_model.AccessTokenScopeReadAddon)
+
+		// the private repository is dropped from the suggestions
+		repos := listRelated(t, token, 1, http.StatusOK)
+		if assert.Len(t, repos, 1) {
+			assert.EqualValues(t, 4, repos[0].ID)
+		}
+
+		// and its own suggestions are hidden
+		listRelated(t, token, 2, http.StatusNotFound)
+	})
+
+	t.Run("PublicOnly", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		token := getUserToken(t, "user2", auth_model.AccessTokenScopePublicOnly, auth_model.AccessTokenScopeReadAddon)
+
+		repos := listRelated(t, token, 1, http.StatusOK)
+		if assert.Len(t, repos, 1) {
+			assert.EqualValues(t, 4, repos[0].ID)
+		}
+
+		listRelated(t, token, 2, http.StatusNotFound)
+	})
+
+	t.Run("NonPositiveLimit", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		// app.ini values are not validated like the admin panel ones, fall back to the default
+		assert.NoError(t, system_model.SetSettings(db.DefaultContext, map[string]string{
+			setting.Config().Addon.MaxRelatedAddons.DynKey(): "-1",
+		}))
+		assert.NoError(t, addon_service.UpdateRelatedAddons(db.DefaultContext))
+
+		token := getUserToken(t, "user2", auth_model.AccessTokenScopeReadAddon)
+
+		assert.Len(t, listRelated(t, token, 1, http.StatusOK), 2)
+	})
+
+	t.Run("NotExist", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		token := getUserToken(t, "user4", auth_model.AccessTokenScopeReadAddon)
+
+		listRelated(t, token, 999999, http.StatusNotFound)
+	})
+}
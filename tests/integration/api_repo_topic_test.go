@@ -182,3 +182,75 @@ func TestAPIRepoTopic(t *testing.T) {
 		AddTokenAuth(token4)
 	MakeRequest(t, req, http.StatusForbidden)
 }
+
+func TestAPIRepoAddonTypeTopic(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	repo2 := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 2})
+
+	token2 := getUserToken(t, user2.Name, auth_model.AccessTokenScopeWriteRepository)
+	url := fmt.Sprintf("/api/v1/repos/%s/%s/topics", user2.Name, repo2.Name)
+
+	// Test replace topics with more than one add-on type
+	req := NewRequestWithJSON(t, "PUT", url, &api.RepoTopicOptions{
+		Topics: []string{"levelset", "worldmap"},
+	}).AddTokenAuth(token2)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	// Test replace topics with a single add-on type
+	req = NewRequestWithJSON(t, "PUT", url, &api.RepoTopicOptions{
+		Topics: []string{"levelset", "supertux"},
+	}).AddTokenAuth(token2)
+	MakeRequest(t, req, http.StatusNoContent)
+
+	// Test add a second add-on type
+	req = NewRequestf(t, "PUT", "%s/%s", url, "worldmap").
+		AddTokenAuth(token2)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	// Test re-add the add-on type the repository already has
+	req = NewRequestf(t, "PUT", "%s/%s", url, "levelset").
+		AddTokenAuth(token2)
+	MakeRequest(t, req, http.StatusNoContent)
+
+	// Test add a topic which is not an add-on type
+	req = NewRequestf(t, "PUT", "%s/%s", url, "christmas").
+		AddTokenAuth(token2)
+	MakeRequest(t, req, http.StatusNoContent)
+
+	req = NewRequest(t, "GET", url).
+		AddTokenAuth(token2)
+	res := MakeRequest(t, req, http.StatusOK)
+	var topics *api.TopicName
+	DecodeJSON(t, res, &topics)
+	assert.ElementsMatch(t, []string{"levelset", "supertux", "christmas"}, topics.TopicNames)
+}
+
+func TestRepoAddonTypeTopic(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	session := loginUser(t, "user2")
+	url := "/user2/repo2/topics"
+
+	// Test save topics with more than one add-on type
+	req := NewRequestWithValues(t, "POST", url, map[string]string{
+		"_csrf":  GetUserCSRFToken(t, session),
+		"topics": "levelset,worldmap",
+	})
+	resp := session.MakeRequest(t, req, http.StatusUnprocessableEntity)
+	var result struct {
+		InvalidTopics []string `json:"invalidTopics"`
+	}
+	DecodeJSON(t, resp, &result)
+	assert.Equal(t, []string{"levelset", "worldmap"}, result.InvalidTopics)
+
+	// Test save topics with a single add-on type
+	req = NewRequestWithValues(t, "POST", url, map[string]string{
+		"_csrf":  GetUserCSRFToken(t, session),
+		"topics": "levelset,supertux",
+	})
+	session.MakeRequest(t, req, http.StatusOK)
+
+	repo2 := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 2})
+	assert.ElementsMatch(t, []string{"levelset", "supertux"}, repo2.Topics)
+}
@@ -466,6 +466,36 @@ func TestAPIDeniesPermissionBasedOnTokenScope(t *testing.T) {
 				},
 			},
 		},
+		{
+			"/api/v1/user/addons/installed",
+			"GET",
+			[]permission{
+				{
+					auth_model.AccessTokenScopeCategoryAddon,
+					auth_model.Read,
+				},
+			},
+		},
+		{
+			"/api/v1/user/addons/installed",
+			"PUT",
+			[]permission{
+				{
+					auth_model.AccessTokenScopeCategoryAddon,
+					auth_model.Write,
+				},
+			},
+		},
+		{
+			"/api/v1/repos/addons/1/related",
+			"GET",
+			[]permission{
+				{
+					auth_model.AccessTokenScopeCategoryAddon,
+					auth_model.Read,
+				},
+			},
+		},
 	}
 
 	// User needs to be admin so that we can verify that tokens without admin
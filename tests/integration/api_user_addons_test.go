@@ -0,0 +1,212 @@
+// Copyright 2024 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	addon_model "code.gitea.io/gitea/models/addon"
+	auth_model "code.gitea.io/gitea/models/auth"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/setting"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/test"
+	"code.gitea.io/gitea/routers"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIInstalledAddons(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	token := getUserToken(t, "user4", auth_model.AccessTokenScopeWriteAddon)
+
+	listInstalled := func(t *testing.T) []*api.InstalledAddon {
+		req := NewRequest(t, "GET", "/api/v1/user/addons/installed").
+			AddTokenAuth(token)
+		resp := MakeRequest(t, req, http.StatusOK)
+
+		var installed []*api.InstalledAddon
+		DecodeJSON(t, resp, &installed)
+		return installed
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		assert.Empty(t, listInstalled(t))
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		req := NewRequestWithJSON(t, "PUT", "/api/v1/user/addons/installed", &api.ReplaceInstalledAddonsOption{
+			Addons: []api.InstalledAddonOption{
+				{ID: 1, Version: "1.0.0"},
+				{ID: 4, Version: "0.2.0"},
+			},
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusOK)
+
+		installed := listInstalled(t)
+		if assert.Len(t, installed, 2) {
+			assert.EqualValues(t, 1, installed[0].ID)
+			assert.Equal(t, "1.0.0", installed[0].Version)
+			assert.EqualValues(t, 4, installed[1].ID)
+			assert.Equal(t, "0.2.0", installed[1].Version)
+		}
+
+		req = NewRequestWithJSON(t, "PUT", "/api/v1/user/addons/installed", &api.ReplaceInstalledAddonsOption{
+			Addons: []api.InstalledAddonOption{
+				{ID: 1, Version: "1.1.0"},
+			},
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusOK)
+
+		installed = listInstalled(t)
+		if assert.Len(t, installed, 1) {
+			assert.EqualValues(t, 1, installed[0].ID)
+			assert.Equal(t, "1.1.0", installed[0].Version)
+		}
+		unittest.AssertCount(t, &addon_model.Installed{UserID: 4}, 1)
+	})
+
+	t.Run("Paginate", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		req := NewRequestWithJSON(t, "PUT", "/api/v1/user/addons/installed", &api.ReplaceInstalledAddonsOption{
+			Addons: []api.InstalledAddonOption{
+				{ID: 1, Version: "1.1.0"},
+				{ID: 4, Version: "0.2.0"},
+				{ID: 10, Version: "2.0.0"},
+			},
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusOK)
+
+		req = NewRequest(t, "GET", "/api/v1/user/addons/installed?page=2&limit=2").
+			AddTokenAuth(token)
+		resp := MakeRequest(t, req, http.StatusOK)
+		assert.Equal(t, "3", resp.Header().Get("X-Total-Count"))
+		assert.Contains(t, resp.Header().Get("Link"), `rel="first"`)
+
+		var installed []*api.InstalledAddon
+		DecodeJSON(t, resp, &installed)
+		if assert.Len(t, installed, 1) {
+			assert.EqualValues(t, 10, installed[0].ID)
+		}
+	})
+
+	t.Run("ReplaceNotPaginated", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+		defer test.MockVariableValue(&setting.API.DefaultPagingNum, 2)()
+
+		req := NewRequestWithJSON(t, "PUT", "/api/v1/user/addons/installed", &api.ReplaceInstalledAddonsOption{
+			Addons: []api.InstalledAddonOption{
+				{ID: 1, Version: "1.1.0"},
+				{ID: 4, Version: "0.2.0"},
+				{ID: 10, Version: "2.0.0"},
+			},
+		}).AddTokenAuth(token)
+		resp := MakeRequest(t, req, http.StatusOK)
+		assert.Empty(t, resp.Header().Get("Link"))
+
+		var installed []*api.InstalledAddon
+		DecodeJSON(t, resp, &installed)
+		assert.Len(t, installed, 3)
+	})
+
+	t.Run("PublicOnly", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		// user2 can read its private repository 2, but not with a public-only token
+		publicOnlyToken := getUserToken(t, "user2", auth_model.AccessTokenScopePublicOnly, auth_model.AccessTokenScopeWriteAddon)
+
+		req := NewRequestWithJSON(t, "PUT", "/api/v1/user/addons/installed", &api.ReplaceInstalledAddonsOption{
+			Addons: []api.InstalledAddonOption{
+				{ID: 1},
+				{ID: 2},
+			},
+		}).AddTokenAuth(publicOnlyToken)
+		MakeRequest(t, req, http.StatusUnprocessableEntity)
+		unittest.AssertCount(t, &addon_model.Installed{UserID: 2}, 0)
+
+		req = NewRequestWithJSON(t, "PUT", "/api/v1/user/addons/installed", &api.ReplaceInstalledAddonsOption{
+			Addons: []api.InstalledAddonOption{
+				{ID: 1},
+			},
+		}).AddTokenAuth(publicOnlyToken)
+		MakeRequest(t, req, http.StatusOK)
+		unittest.AssertCount(t, &addon_model.Installed{UserID: 2}, 1)
+
+		// private add-ons synced with a full token are not listed
+		fullToken := getUserToken(t, "user2", auth_model.AccessTokenScopeWriteAddon)
+		req = NewRequestWithJSON(t, "PUT", "/api/v1/user/addons/installed", &api.ReplaceInstalledAddonsOption{
+			Addons: []api.InstalledAddonOption{
+				{ID: 1},
+				{ID: 2},
+			},
+		}).AddTokenAuth(fullToken)
+		MakeRequest(t, req, http.StatusOK)
+
+		req = NewRequest(t, "GET", "/api/v1/user/addons/installed").
+			AddTokenAuth(publicOnlyToken)
+		resp := MakeRequest(t, req, http.StatusOK)
+		assert.Equal(t, "1", resp.Header().Get("X-Total-Count"))
+
+		var installed []*api.InstalledAddon
+		DecodeJSON(t, resp, &installed)
+		if assert.Len(t, installed, 1) {
+			assert.EqualValues(t, 1, installed[0].ID)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		// duplicated add-on
+		req := NewRequestWithJSON(t, "PUT", "/api/v1/user/addons/installed", &api.ReplaceInstalledAddonsOption{
+			Addons: []api.InstalledAddonOption{
+				{ID: 1, Version: "1.0.0"},
+				{ID: 1, Version: "1.1.0"},
+			},
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+		// private repository the user can't read
+		req = NewRequestWithJSON(t, "PUT", "/api/v1/user/addons/installed", &api.ReplaceInstalledAddonsOption{
+			Addons: []api.InstalledAddonOption{
+				{ID: 2},
+			},
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+		// nonexistent repository
+		req = NewRequestWithJSON(t, "PUT", "/api/v1/user/addons/installed", &api.ReplaceInstalledAddonsOption{
+			Addons: []api.InstalledAddonOption{
+				{ID: 999999},
+			},
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+		assert.Len(t, listInstalled(t), 3)
+	})
+}
+
+func TestAPIAddonsDisabled(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	defer test.MockVariableValue(&setting.Addon.Enabled, false)()
+	defer test.MockVariableValue(&testWebRoutes, routers.NormalRoutes())()
+
+	token := getUserToken(t, "user4", auth_model.AccessTokenScopeWriteAddon)
+
+	req := NewRequest(t, "GET", "/api/v1/user/addons/installed").
+		AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNotFound)
+
+	req = NewRequest(t, "GET", "/api/v1/repos/addons/1/related").
+		AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNotFound)
+}
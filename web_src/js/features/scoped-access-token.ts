@@ -8,6 +8,7 @@ export async function initScopedAccessTokenCategories() {
   try {
     const View = createApp(ScopedAccessTokenSelector, {
       isAdmin: JSON.parse(el.getAttribute('data-is-admin')),
+      isAddonEnabled: JSON.parse(el.getAttribute('data-is-addon-enabled')),
       noAccessLabel: el.getAttribute('data-no-access-label'),
       readLabel: el.getAttribute('data-read-label'),
       writeLabel: el.getAttribute('data-write-label'),